@@ -4,7 +4,11 @@ CREATE TABLE Users (
     venmo_username VARCHAR(255),
     dietary_restrictions TEXT,
     email VARCHAR(255),
-    joined_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    timezone VARCHAR(64),
+    quiet_hours_start TIME,
+    quiet_hours_end TIME,
+    joined_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    anonymized_at TIMESTAMP NULL
 );
 
 -- 2. Groups Table
@@ -12,16 +16,66 @@ CREATE TABLE Groups (
     group_id INT AUTO_INCREMENT PRIMARY KEY,
     name VARCHAR(255) UNIQUE,
     description TEXT,
+    category VARCHAR(64),
+    color CHAR(7),
+    icon_url TEXT,
+    banner_url TEXT,
+    widget_enabled BOOLEAN DEFAULT FALSE,
+    sheet_id VARCHAR(255),
+    sheet_synced_at TIMESTAMP NULL,
     is_open BOOLEAN DEFAULT TRUE,
     chat_inactivity_days INT DEFAULT 0,
     event_inactivity_days INT DEFAULT 0,
+    inactivity_grace_days INT DEFAULT 7,
+    dormancy_days INT DEFAULT 0,
+    dormancy_nudged_at TIMESTAMP NULL,
+    monthly_report_enabled BOOLEAN DEFAULT TRUE,
+    monthly_report_sent_at TIMESTAMP NULL,
+    spotlight_first_timers BOOLEAN DEFAULT FALSE,
+    hype_days VARCHAR(64),
+    attendance_auto_assume ENUM('none', 'attended', 'absent') DEFAULT 'none',
+    streak_freezes_allowed INT DEFAULT 2,
+    points_host INT DEFAULT 0,
+    points_attend INT DEFAULT 0,
+    points_guest INT DEFAULT 0,
+    points_feedback INT DEFAULT 0,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     channel_id VARCHAR(255),
+    channel_missing BOOLEAN DEFAULT FALSE,
     contributor_events_required INT DEFAULT 3,
     new_member_deposit DECIMAL(10,2),
+    dues_amount DECIMAL(10,2),
+    dues_period ENUM('monthly', 'quarterly', 'yearly') DEFAULT 'monthly',
+    dues_required_for_rsvp BOOLEAN DEFAULT FALSE,
     new_members_can_create_events BOOLEAN DEFAULT TRUE,
+    new_member_days INT DEFAULT 0,
+    new_member_events INT,
+    max_open_events_per_host INT DEFAULT 0,
+    max_events_per_week INT DEFAULT 0,
     event_approval_mode ENUM('none', 'public', 'all') DEFAULT 'public',
-    event_attendee_management_mode ENUM('host', 'self') DEFAULT 'host'
+    event_attendee_management_mode ENUM('host', 'self') DEFAULT 'host',
+    approval_policy ENUM('any_leader', 'two_leaders', 'reviewer_role') DEFAULT 'any_leader',
+    approval_reviewer_role_id VARCHAR(255),
+    approval_escalate_hours INT DEFAULT 24,
+    approval_auto_approve BOOLEAN DEFAULT FALSE,
+    rsvp_early_access_hours INT DEFAULT 0,
+    waitlist_prompt_threshold INT DEFAULT 5,
+    standby_window_hours INT DEFAULT 0,
+    late_cancel_hours INT DEFAULT 0,
+    late_cancel_penalty ENUM('none', 'deprioritize', 'lockout') DEFAULT 'none',
+    late_cancel_penalty_days INT DEFAULT 30,
+    waitlist_priority ENUM('fifo', 'contributors_first', 'fewest_attended', 'host') DEFAULT 'fifo',
+    required_role_id VARCHAR(255),
+    required_role_grace_days INT DEFAULT 7,
+    waiver_text TEXT,
+    waiver_updated_at TIMESTAMP NULL,
+    announcement_role_id VARCHAR(255),
+    welcome_dm_enabled BOOLEAN DEFAULT TRUE,
+    welcome_message TEXT,
+    intro_thread_enabled BOOLEAN DEFAULT FALSE,
+    intro_thread_id VARCHAR(255),
+    intro_questions TEXT,
+    deleted_at TIMESTAMP NULL
 );
 
 -- 3. Group Members Table
@@ -30,49 +84,556 @@ CREATE TABLE GroupMembers (
     user_id VARCHAR(255),
     joined_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     is_leader BOOLEAN DEFAULT FALSE,
+    missing_role_since TIMESTAMP NULL,
+    inactivity_warned_at TIMESTAMP NULL,
+    dues_paid_until DATE,
+    dues_reminded_at TIMESTAMP NULL,
     FOREIGN KEY (group_id) REFERENCES Groups(group_id),
     FOREIGN KEY (user_id) REFERENCES Users(user_id),
     PRIMARY KEY (group_id, user_id)
 );
 
+CREATE INDEX idx_group_members_user ON GroupMembers (user_id);
+
 -- 4. Events Table
 CREATE TABLE Events (
     event_id INT AUTO_INCREMENT PRIMARY KEY,
+    short_code VARCHAR(16) UNIQUE,
     group_id INT,
+    series_id INT,
+    split_from_event_id INT,
+    venue_id INT,
     host_id VARCHAR(255),
     name VARCHAR(255),
     date_time TIMESTAMP,
+    end_date_time TIMESTAMP NULL,
+    rsvp_closes_at TIMESTAMP NULL,
     location_name VARCHAR(255),
     location_address TEXT,
+    latitude DECIMAL(9,6),
+    longitude DECIMAL(9,6),
     description TEXT,
     max_attendees INT,
+    max_guests INT,
+    cost_mode ENUM('free', 'fixed', 'suggested') DEFAULT 'free',
+    cost DECIMAL(10,2),
+    currency CHAR(3) DEFAULT 'USD',
+    host_fronts_cost BOOLEAN DEFAULT FALSE,
     is_public BOOLEAN DEFAULT TRUE,
+    hype_enabled BOOLEAN DEFAULT TRUE,
+    hype_last_days_left INT,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    announce_at TIMESTAMP NULL,
+    message_id VARCHAR(255),
+    message_missing BOOLEAN DEFAULT FALSE,
     thread_id VARCHAR(255),
-    status ENUM('pending', 'approved', 'rejected') DEFAULT 'pending',
+    scheduled_event_id VARCHAR(255),
+    checkin_code VARCHAR(16) UNIQUE,
+    guest_rsvp_token VARCHAR(64) UNIQUE,
+    status ENUM('pending', 'approved', 'rejected', 'changes_requested', 'canceled') DEFAULT 'pending',
+    review_note TEXT,
+    review_round INT DEFAULT 1,
+    approval_escalation_level TINYINT DEFAULT 0,
+    approval_escalated_at TIMESTAMP NULL,
+    phase ENUM('scheduled', 'announced', 'rsvp_closed', 'in_progress', 'completed') DEFAULT 'scheduled',
+    host_handoff_stage ENUM('none', 'cohosts', 'leaders', 'contributors') DEFAULT 'none',
+    host_handoff_started_at TIMESTAMP NULL,
+    weather_alerted_at TIMESTAMP NULL,
+    capacity_prompted_at TIMESTAMP NULL,
+    current_stop INT,
+    here_message_id VARCHAR(255),
+    attendance_roster_sent_at TIMESTAMP NULL,
+    attendance_confirmed_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
     FOREIGN KEY (group_id) REFERENCES Groups(group_id),
-    FOREIGN KEY (host_id) REFERENCES Users(user_id)
+    FOREIGN KEY (host_id) REFERENCES Users(user_id),
+    FOREIGN KEY (split_from_event_id) REFERENCES Events(event_id),
+    CHECK (max_attendees IS NULL OR max_attendees > 0),
+    CHECK (max_guests IS NULL OR max_guests >= 0),
+    CHECK (cost IS NULL OR cost >= 0)
 );
 
+CREATE INDEX idx_events_group_date_status ON Events (group_id, date_time, status);
+CREATE INDEX idx_events_message ON Events (message_id);
+CREATE INDEX idx_events_thread ON Events (thread_id);
+
 -- 5. Event Attendees Table
 CREATE TABLE EventAttendees (
     event_id INT,
     user_id VARCHAR(255),
-    rsvp_status ENUM('ATTENDING', 'WAITLIST', 'DECLINED') DEFAULT 'ATTENDING',
+    rsvp_status ENUM('ATTENDING', 'WAITLIST', 'STANDBY', 'DECLINED') DEFAULT 'ATTENDING',
     calendar_event_id VARCHAR(255),
     plus_one_calendar_event_id VARCHAR(255),
+    rsvp_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_guest BOOLEAN DEFAULT FALSE,
+    split_priority INT,
+    notified_waitlist_position INT,
+    attended BOOLEAN NULL,
+    checked_in_at TIMESTAMP NULL,
+    running_late_eta TIMESTAMP NULL,
     FOREIGN KEY (event_id) REFERENCES Events(event_id),
     FOREIGN KEY (user_id) REFERENCES Users(user_id),
     PRIMARY KEY (event_id, user_id)
 );
 
+CREATE INDEX idx_event_attendees_user ON EventAttendees (user_id);
+
 -- 6. Bills Table
 CREATE TABLE Bills (
     bill_id INT AUTO_INCREMENT PRIMARY KEY,
     event_id INT,
     user_id VARCHAR(255),
+    payee_id VARCHAR(255),
     amount DECIMAL(10,2),
     paid BOOLEAN DEFAULT FALSE,
+    last_reminded_at TIMESTAMP NULL,
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    FOREIGN KEY (payee_id) REFERENCES Users(user_id),
+    CHECK (amount >= 0)
+);
+
+-- 7. Availability Table
+CREATE TABLE Availability (
+    availability_id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id VARCHAR(255),
+    day_of_week TINYINT,
+    start_time TIME,
+    end_time TIME,
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    CHECK (day_of_week BETWEEN 0 AND 6),
+    CHECK (end_time <> start_time)
+);
+
+-- 8. Audit Log Table
+CREATE TABLE AuditLog (
+    log_id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT,
+    user_id VARCHAR(255),
+    action VARCHAR(255),
+    details TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id)
+);
+
+-- 9. Event Feedback Table
+CREATE TABLE EventFeedback (
+    event_id INT,
+    user_id VARCHAR(255),
+    rating TINYINT,
+    venue_rating TINYINT,
+    comment TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    PRIMARY KEY (event_id, user_id),
+    CHECK (rating BETWEEN 1 AND 5),
+    CHECK (venue_rating BETWEEN 1 AND 5)
+);
+
+-- 10. Waiver Acknowledgements Table
+CREATE TABLE WaiverAcknowledgements (
+    group_id INT,
+    user_id VARCHAR(255),
+    acknowledged_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    PRIMARY KEY (group_id, user_id)
+);
+
+-- 11. Expenses Table
+CREATE TABLE Expenses (
+    expense_id INT AUTO_INCREMENT PRIMARY KEY,
+    event_id INT,
+    payer_id VARCHAR(255),
+    description VARCHAR(255),
+    amount DECIMAL(10,2),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    FOREIGN KEY (payer_id) REFERENCES Users(user_id),
+    CHECK (amount > 0)
+);
+
+-- 12. External Guests Table
+CREATE TABLE ExternalGuests (
+    guest_id INT AUTO_INCREMENT PRIMARY KEY,
+    event_id INT,
+    name VARCHAR(255),
+    email VARCHAR(255),
+    rsvp_status ENUM('ATTENDING', 'WAITLIST', 'DECLINED') DEFAULT 'ATTENDING',
+    rsvp_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES Events(event_id)
+);
+
+-- 13. Event Series Table
+CREATE TABLE EventSeries (
+    series_id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT,
+    host_id VARCHAR(255),
+    name VARCHAR(255),
+    description TEXT,
+    message_id VARCHAR(255),
+    thread_id VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    FOREIGN KEY (host_id) REFERENCES Users(user_id)
+);
+
+CREATE INDEX idx_event_series_message ON EventSeries (message_id);
+CREATE INDEX idx_event_series_thread ON EventSeries (thread_id);
+
+ALTER TABLE Events ADD FOREIGN KEY (series_id) REFERENCES EventSeries(series_id);
+
+-- 14. Event Tags Table
+CREATE TABLE EventTags (
+    event_id INT,
+    tag VARCHAR(64),
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    PRIMARY KEY (event_id, tag)
+);
+
+CREATE INDEX idx_event_tags_tag ON EventTags (tag);
+
+-- 15. Subscriptions Table
+CREATE TABLE Subscriptions (
+    subscription_id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id VARCHAR(255),
+    kind ENUM('tag', 'group', 'location'),
+    value VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    UNIQUE (user_id, kind, value)
+);
+
+-- 16. Event Announcements Table
+CREATE TABLE EventAnnouncements (
+    event_id INT,
+    channel_id VARCHAR(255),
+    message_id VARCHAR(255),
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    PRIMARY KEY (event_id, channel_id)
+);
+
+CREATE INDEX idx_event_announcements_message ON EventAnnouncements (message_id);
+
+-- 17. Member Notes Table
+CREATE TABLE MemberNotes (
+    note_id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT,
+    user_id VARCHAR(255),
+    author_id VARCHAR(255),
+    note TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    FOREIGN KEY (author_id) REFERENCES Users(user_id)
+);
+
+-- 18. Reports Table
+CREATE TABLE Reports (
+    report_id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT,
+    event_id INT,
+    reporter_id VARCHAR(255),
+    content TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    acknowledged_by VARCHAR(255),
+    acknowledged_at TIMESTAMP NULL,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    FOREIGN KEY (reporter_id) REFERENCES Users(user_id),
+    FOREIGN KEY (acknowledged_by) REFERENCES Users(user_id)
+);
+
+-- 19. Event Cohosts Table
+CREATE TABLE EventCohosts (
+    event_id INT,
+    user_id VARCHAR(255),
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    PRIMARY KEY (event_id, user_id)
+);
+
+-- 20. Venues Table
+CREATE TABLE Venues (
+    venue_id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT,
+    name VARCHAR(255),
+    address TEXT,
+    capacity INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    UNIQUE (group_id, name)
+);
+
+ALTER TABLE Events ADD FOREIGN KEY (venue_id) REFERENCES Venues(venue_id);
+
+-- 21. Notifications Table
+CREATE TABLE Notifications (
+    notification_id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id VARCHAR(255),
+    kind VARCHAR(64),
+    dedupe_key VARCHAR(255),
+    body TEXT,
+    status ENUM('queued', 'sent', 'failed', 'skipped') DEFAULT 'queued',
+    error TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    UNIQUE (user_id, dedupe_key)
+);
+
+CREATE INDEX idx_notifications_status ON Notifications (status, created_at);
+
+-- 22. Streak Freezes Table
+CREATE TABLE StreakFreezes (
+    group_id INT,
+    user_id VARCHAR(255),
+    event_id INT,
+    granted_by VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    FOREIGN KEY (granted_by) REFERENCES Users(user_id),
+    PRIMARY KEY (group_id, user_id, event_id)
+);
+
+-- 23. Point Transactions Table
+CREATE TABLE PointTransactions (
+    transaction_id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT,
+    user_id VARCHAR(255),
+    event_id INT,
+    perk_id INT,
+    amount INT,
+    reason ENUM('host', 'attend', 'guest', 'feedback', 'redeem', 'adjust'),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    FOREIGN KEY (event_id) REFERENCES Events(event_id)
+);
+
+CREATE INDEX idx_point_transactions_member ON PointTransactions (group_id, user_id);
+
+-- 24. Perks Table
+CREATE TABLE Perks (
+    perk_id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT,
+    name VARCHAR(255),
+    kind ENUM('early_rsvp', 'free_entry', 'custom') DEFAULT 'custom',
+    cost INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    CHECK (cost > 0)
+);
+
+ALTER TABLE PointTransactions ADD FOREIGN KEY (perk_id) REFERENCES Perks(perk_id);
+
+-- 25. Milestone Roles Table
+CREATE TABLE MilestoneRoles (
+    milestone_id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT,
+    kind ENUM('attended', 'hosted'),
+    threshold INT,
+    role_id VARCHAR(255),
+    group_key INT AS (COALESCE(group_id, 0)) STORED,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    UNIQUE (group_key, kind, threshold),
+    CHECK (threshold > 0)
+);
+
+-- 26. Late Cancellations Table
+CREATE TABLE LateCancellations (
+    group_id INT,
+    user_id VARCHAR(255),
+    event_id INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    forgiven_by VARCHAR(255),
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    FOREIGN KEY (forgiven_by) REFERENCES Users(user_id),
+    PRIMARY KEY (group_id, user_id, event_id)
+);
+
+-- 27. Event Tasks Table
+CREATE TABLE EventTasks (
+    task_id INT AUTO_INCREMENT PRIMARY KEY,
+    event_id INT,
+    description VARCHAR(255),
+    assignee_id VARCHAR(255),
+    is_done BOOLEAN DEFAULT FALSE,
+    reminded_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    FOREIGN KEY (assignee_id) REFERENCES Users(user_id)
+);
+
+-- 28. Task Templates Table
+CREATE TABLE TaskTemplates (
+    template_id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT,
+    description VARCHAR(255),
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id)
+);
+
+-- 29. Gear Table
+CREATE TABLE Gear (
+    gear_id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT,
+    name VARCHAR(255),
+    description TEXT,
+    holder_id VARCHAR(255),
+    borrowed_at TIMESTAMP NULL,
+    due_at TIMESTAMP NULL,
+    return_reminded_at TIMESTAMP NULL,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    FOREIGN KEY (holder_id) REFERENCES Users(user_id)
+);
+
+-- 30. Event Gear Table
+CREATE TABLE EventGear (
+    event_id INT,
+    gear_id INT,
+    bringer_id VARCHAR(255),
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    FOREIGN KEY (gear_id) REFERENCES Gear(gear_id),
+    FOREIGN KEY (bringer_id) REFERENCES Users(user_id),
+    PRIMARY KEY (event_id, gear_id)
+);
+
+-- 31. Polls Table
+CREATE TABLE Polls (
+    poll_id INT AUTO_INCREMENT PRIMARY KEY,
+    event_id INT,
+    creator_id VARCHAR(255),
+    message_id VARCHAR(255),
+    question VARCHAR(255),
+    target_field VARCHAR(64),
+    closes_at TIMESTAMP NULL,
+    closed_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    FOREIGN KEY (creator_id) REFERENCES Users(user_id)
+);
+
+-- 32. Poll Options Table
+CREATE TABLE PollOptions (
+    option_id INT AUTO_INCREMENT PRIMARY KEY,
+    poll_id INT,
+    label VARCHAR(100),
+    FOREIGN KEY (poll_id) REFERENCES Polls(poll_id)
+);
+
+-- 33. Poll Votes Table
+CREATE TABLE PollVotes (
+    poll_id INT,
+    user_id VARCHAR(255),
+    option_id INT,
+    FOREIGN KEY (poll_id) REFERENCES Polls(poll_id),
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    FOREIGN KEY (option_id) REFERENCES PollOptions(option_id),
+    PRIMARY KEY (poll_id, user_id)
+);
+
+-- 34. Event Stops Table
+CREATE TABLE EventStops (
+    event_id INT,
+    position INT,
+    location_name VARCHAR(255),
+    location_address TEXT,
+    starts_at TIMESTAMP,
+    reminded_at TIMESTAMP NULL,
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    PRIMARY KEY (event_id, position)
+);
+
+-- 35. Short Links Table
+CREATE TABLE ShortLinks (
+    code VARCHAR(16) PRIMARY KEY,
+    event_id INT,
+    target_url TEXT,
+    clicks INT DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES Events(event_id)
+);
+
+-- 36. API Keys Table
+CREATE TABLE ApiKeys (
+    key_id INT AUTO_INCREMENT PRIMARY KEY,
+    key_hash CHAR(64) UNIQUE,
+    label VARCHAR(255),
+    group_id INT,
+    created_by VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP NULL,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    FOREIGN KEY (created_by) REFERENCES Users(user_id)
+);
+
+-- 37. Webhook Subscriptions Table
+CREATE TABLE WebhookSubscriptions (
+    subscription_id INT AUTO_INCREMENT PRIMARY KEY,
+    key_id INT,
+    trigger_type ENUM('event_created', 'rsvp_created', 'event_canceled'),
+    target_url TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (key_id) REFERENCES ApiKeys(key_id)
+);
+
+-- 38. Group Announcers Table
+CREATE TABLE GroupAnnouncers (
+    announcer_id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT,
+    platform ENUM('slack', 'telegram', 'matrix'),
+    target VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (group_id) REFERENCES Groups(group_id),
+    UNIQUE (group_id, platform, target)
+);
+
+-- 39. Message Templates Table
+CREATE TABLE MessageTemplates (
+    template_key VARCHAR(64) PRIMARY KEY,
+    body TEXT,
+    updated_by VARCHAR(255),
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (updated_by) REFERENCES Users(user_id)
+);
+
+-- 40. Blackout Dates Table
+CREATE TABLE BlackoutDates (
+    blackout_date DATE PRIMARY KEY,
+    reason VARCHAR(255),
+    created_by VARCHAR(255),
+    FOREIGN KEY (created_by) REFERENCES Users(user_id)
+);
+
+-- 41. Event Approval Votes Table
+CREATE TABLE EventApprovalVotes (
+    event_id INT,
+    review_round INT,
+    user_id VARCHAR(255),
+    vote ENUM('approve', 'reject', 'changes_requested'),
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES Events(event_id),
+    FOREIGN KEY (user_id) REFERENCES Users(user_id),
+    PRIMARY KEY (event_id, review_round, user_id)
+);
+
+-- 42. Event Revisions Table
+CREATE TABLE EventRevisions (
+    revision_id INT AUTO_INCREMENT PRIMARY KEY,
+    event_id INT,
+    user_id VARCHAR(255),
+    field_name VARCHAR(64),
+    old_value TEXT,
+    new_value TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     FOREIGN KEY (event_id) REFERENCES Events(event_id),
     FOREIGN KEY (user_id) REFERENCES Users(user_id)
 );
+
+CREATE INDEX idx_event_revisions_event ON EventRevisions (event_id, created_at);